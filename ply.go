@@ -1,15 +1,18 @@
 package plyfile
 
 import (
+	"bufio"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
-	"unsafe"
+	"sync"
 )
 
 var propertySizes = map[string]int{
@@ -23,29 +26,97 @@ var propertySizes = map[string]int{
 	"double": 8,
 }
 
-const regExpFormat = "^format (ascii|binary_little_endian).*"
+const FormatAscii = "ascii"
+const FormatBinaryLittleEndian = "binary_little_endian"
+const FormatBinaryBigEndian = "binary_big_endian"
+
+const regExpFormat = "^format (ascii|binary_little_endian|binary_big_endian).*"
 const regExpComment = "^comment (.*)"
 const regExpElement = "^element (\\w*) (\\d*)"
 const regExpProperty = "^property (char|uchar|short|ushort|int|uint|float|double) (\\w*)"
+const regExpPropertyList = "^property list (char|uchar|short|ushort|int|uint|float|double) (char|uchar|short|ushort|int|uint|float|double) (\\w*)"
 const headerEnd = "end_header"
 
+// byteOrder returns the encoding/binary.ByteOrder matching a PLY header
+// format string, defaulting to little-endian for the ascii format (where
+// byte order is irrelevant).
+func byteOrder(format string) binary.ByteOrder {
+	if format == FormatBinaryBigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
 type ElementReader struct {
-	pos     int64
-	offset  int64
-	file    *os.File
-	element *element
+	pos        int64
+	offset     int64
+	lineOffset int64
+	file       *os.File
+	element    *element
+	format     string
+	order      binary.ByteOrder
+
+	// asciiScanner/asciiNextLine hold a forward-only scanner positioned
+	// just past the last ascii line it produced, so sequential reads
+	// (ReadNext, ReadRange) resume it instead of rescanning from the body
+	// start on every row. A request for a line before asciiNextLine (a
+	// backward seek) forces a rescan from the start. It reads through an
+	// offsetReader rather than r.file directly, since r.file is shared with
+	// any other ElementReader on the same PlyFile and may be seeked
+	// elsewhere between calls.
+	asciiScanner  *bufio.Scanner
+	asciiNextLine int64
+
+	// singleType/singleSlice cache the one-element slice ReadNext reads
+	// each row into, so repeated ReadNext calls for the same struct type
+	// reuse its backing array instead of allocating one per row.
+	singleType  reflect.Type
+	singleSlice reflect.Value
+}
+
+// offsetReader adapts an io.ReaderAt to io.Reader while tracking its own
+// absolute position. Unlike wrapping the *os.File directly, this doesn't
+// depend on (or disturb) the shared file's current seek position, so it
+// stays correct when another ElementReader on the same PlyFile seeks the
+// file in between reads.
+type offsetReader struct {
+	r   io.ReaderAt
+	pos int64
+}
+
+func (o *offsetReader) Read(p []byte) (int, error) {
+	n, err := o.r.ReadAt(p, o.pos)
+	o.pos += int64(n)
+	return n, err
 }
 
 type element struct {
 	Name       string
 	Count      int64
 	Properties []*property
+
+	// bodyOffset is the byte offset of the element's first row, relative to
+	// the start of the file. Populated once by PlyFile.buildLayout.
+	bodyOffset int64
+
+	// rowOffsets holds the byte offset of every row plus a trailing entry
+	// for the byte just past the last row. It has len(Count)+1 entries and
+	// is only populated for elements with variable-size (list) rows, where
+	// PointByteSize can't be used to compute a row's position directly.
+	rowOffsets []int64
 }
 
 type property struct {
 	Type string
 	Name string
 	Size int
+
+	// IsList marks a `property list <count type> <value type> <name>`
+	// property, whose row contribution is a count prefix followed by that
+	// many ValueType values rather than a single fixed-size scalar.
+	IsList    bool
+	CountType string
+	ValueType string
 }
 
 type header struct {
@@ -61,10 +132,6 @@ type PlyFile struct {
 	header    *header
 }
 
-func memcpy(bits []byte, dest unsafe.Pointer) {
-	copy(unsafe.Slice((*byte)(unsafe.Pointer(dest)), len(bits)), bits)
-}
-
 func propertySize(t string) int {
 	if size, ok := propertySizes[t]; ok {
 		return size
@@ -73,14 +140,24 @@ func propertySize(t string) int {
 	return 0
 }
 
+// PointByteSize returns the fixed byte size of one row of the element, or
+// -1 if the element has one or more list properties and so has no fixed
+// row size (its rows must be located through rowOffsets instead).
 func (e element) PointByteSize() int {
 	size := 0
 	for _, prop := range e.Properties {
+		if prop.IsList {
+			return -1
+		}
 		size += prop.Size
 	}
 	return size
 }
 
+func (e *element) isVariable() bool {
+	return e.PointByteSize() == -1
+}
+
 func Open(name string) (*PlyFile, error) {
 	file, err := os.Open(name)
 
@@ -102,14 +179,86 @@ func Open(name string) (*PlyFile, error) {
 
 	ply.parseHeader()
 
-	if ply.header.Format != "binary_little_endian" {
+	switch ply.header.Format {
+	case FormatAscii, FormatBinaryLittleEndian, FormatBinaryBigEndian:
+		// supported
+	default:
+		file.Close()
+		return nil, errors.New(fmt.Sprintf("unsupported format '%s'", ply.header.Format))
+	}
+
+	if err = ply.buildLayout(); err != nil {
 		file.Close()
-		return nil, errors.New("binary_little_endian support only")
+		return nil, err
 	}
 
 	return ply, nil
 }
 
+// buildLayout computes each element's byte offset relative to the file and,
+// for elements with variable-size (list) rows, scans the body once to
+// memoize every row's offset so Seek/ReadAt stay O(1) afterwards. Ascii
+// files are addressed by line instead of byte offset, so layout is skipped.
+func (f *PlyFile) buildLayout() error {
+	if f.header.Format == FormatAscii {
+		return nil
+	}
+
+	order := byteOrder(f.header.Format)
+	cursor := f.header.offset
+
+	for _, e := range f.header.Elements {
+		e.bodyOffset = cursor
+
+		if !e.isVariable() {
+			cursor += e.Count * int64(e.PointByteSize())
+			continue
+		}
+
+		e.rowOffsets = make([]int64, e.Count+1)
+		rowCursor := cursor
+		for row := int64(0); row < e.Count; row++ {
+			e.rowOffsets[row] = rowCursor
+
+			size, err := rowByteSize(f.file, rowCursor, e.Properties, order)
+			if err != nil {
+				return err
+			}
+			rowCursor += size
+		}
+		e.rowOffsets[e.Count] = rowCursor
+		cursor = rowCursor
+	}
+
+	return nil
+}
+
+// rowByteSize measures the on-disk size of a single row starting at offset,
+// reading only the list-count prefixes it needs via ReadAt so it never
+// disturbs the file's shared read position.
+func rowByteSize(file *os.File, offset int64, props []*property, order binary.ByteOrder) (int64, error) {
+	cursor := offset
+
+	for _, prop := range props {
+		if !prop.IsList {
+			cursor += int64(prop.Size)
+			continue
+		}
+
+		countSize := propertySize(prop.CountType)
+		buf := make([]byte, countSize)
+		if _, err := file.ReadAt(buf, cursor); err != nil {
+			return 0, err
+		}
+		cursor += int64(countSize)
+
+		count := decodeBinaryUint(prop.CountType, buf, order)
+		cursor += int64(count) * int64(propertySize(prop.ValueType))
+	}
+
+	return cursor - offset, nil
+}
+
 func (f *PlyFile) Close() error {
 	f.header.offset = 0
 	f.headerStr = ""
@@ -131,8 +280,20 @@ func (f *PlyFile) readHeader() error {
 
 		f.headerStr = f.headerStr + string(buf[:n])
 		if pos := strings.Index(f.headerStr, "end_header"); pos != -1 {
+			// The newline terminating "end_header" may not have been read
+			// yet if it landed past this chunk's boundary; keep reading
+			// until it's in hand instead of assuming it already is.
+			end := pos + len("end_header")
+			for end >= len(f.headerStr) {
+				n, err := f.file.Read(buf)
+				if err != nil {
+					return errors.New("failed read header")
+				}
+				f.headerStr = f.headerStr + string(buf[:n])
+			}
+
 			isHeaderEnd = true
-			f.header.offset = int64(pos + len("end_header") + 1)
+			f.header.offset = int64(end + 1)
 			f.headerStr = f.headerStr[:f.header.offset]
 		}
 	}
@@ -150,6 +311,7 @@ func (f *PlyFile) parseHeader() {
 	rFormat, _ := regexp.Compile(regExpFormat)
 	rComment, _ := regexp.Compile(regExpComment)
 	rElement, _ := regexp.Compile(regExpElement)
+	rPropertyList, _ := regexp.Compile(regExpPropertyList)
 	rProperty, _ := regexp.Compile(regExpProperty)
 
 	var currElement *element = nil
@@ -178,6 +340,19 @@ func (f *PlyFile) parseHeader() {
 			continue
 		}
 
+		if res := rPropertyList.FindAllStringSubmatch(line, -1); len(res) != 0 {
+			currElement.Properties = append(
+				currElement.Properties,
+				&property{
+					Name:      res[0][3],
+					IsList:    true,
+					CountType: res[0][1],
+					ValueType: res[0][2],
+				},
+			)
+			continue
+		}
+
 		if res := rProperty.FindAllStringSubmatch(line, -1); len(res) != 0 {
 			currElement.Properties = append(
 				currElement.Properties,
@@ -214,22 +389,25 @@ func (f *PlyFile) getElement(name string) *element {
 	return nil
 }
 
-func (f *PlyFile) getElementOffset(name string) int64 {
+// getElementLineOffset returns the number of ascii body lines (one per row)
+// preceding the named element, for use as a line-based analogue of
+// getElementOffset when the file format is ascii.
+func (f *PlyFile) getElementLineOffset(name string) int64 {
 	if !f.Has(name) {
 		return -1
 	}
 
-	var offset int64 = 0
+	var lines int64 = 0
 
 	for _, e := range f.header.Elements {
 		if e.Name != name {
-			offset += e.Count * int64(e.PointByteSize())
+			lines += e.Count
 		} else {
 			break
 		}
 	}
 
-	return offset
+	return lines
 }
 
 func (f *PlyFile) GetElementReader(name string) (*ElementReader, error) {
@@ -237,12 +415,22 @@ func (f *PlyFile) GetElementReader(name string) (*ElementReader, error) {
 		return nil, errors.New(fmt.Sprintf("unknown element '%s'", name))
 	}
 
-	return &ElementReader{
+	reader := &ElementReader{
 		file:    f.file,
-		offset:  f.header.offset + f.getElementOffset(name),
 		pos:     0,
 		element: f.getElement(name),
-	}, nil
+		format:  f.header.Format,
+		order:   byteOrder(f.header.Format),
+	}
+
+	if f.header.Format == FormatAscii {
+		reader.offset = f.header.offset
+		reader.lineOffset = f.getElementLineOffset(name)
+	} else {
+		reader.offset = reader.element.bodyOffset
+	}
+
+	return reader, nil
 }
 
 func (r *ElementReader) Seek(pos int64) error {
@@ -259,22 +447,290 @@ func (r *ElementReader) Reset() error {
 }
 
 func (r *ElementReader) ReadNext(pointer interface{}) (int64, error) {
-	_, err := r.file.Seek(r.offset+(r.pos*int64(r.element.PointByteSize())), 0)
+	if r.pos >= r.element.Count {
+		return -1, io.EOF
+	}
+
+	t := reflect.TypeOf(pointer)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return -1, errors.New("pointer must be a pointer to a struct")
+	}
 
+	if r.singleType != t.Elem() {
+		r.singleType = t.Elem()
+		r.singleSlice = reflect.New(reflect.SliceOf(t.Elem()))
+	}
+
+	n, err := r.ReadRange(r.pos, 1, r.singleSlice.Interface())
 	if err != nil {
 		return -1, err
 	}
+	if n == 0 {
+		return -1, io.EOF
+	}
 
-	buf := make([]byte, r.element.PointByteSize())
+	reflect.ValueOf(pointer).Elem().Set(r.singleSlice.Elem().Index(0))
+	r.pos++
 
-	if r.pos >= r.element.Count {
-		return -1, io.EOF
+	return r.pos, nil
+}
+
+// tagFieldIndexCache memoizes, per Go struct type, the mapping from a
+// `ply:"name"` tag to its field index. Building it requires walking every
+// field with reflection, so it's done once per type rather than once per
+// row.
+var tagFieldIndexCache sync.Map
+
+func tagFieldIndex(t reflect.Type) map[string]int {
+	if cached, ok := tagFieldIndexCache.Load(t); ok {
+		return cached.(map[string]int)
 	}
 
-	_, err = r.file.Read(buf)
+	m := make(map[string]int)
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			if tag := t.Field(i).Tag.Get("ply"); tag != "" {
+				m[tag] = i
+			}
+		}
+	}
 
-	if err == io.EOF {
-		return -1, err
+	tagFieldIndexCache.Store(t, m)
+	return m
+}
+
+// converterKey identifies a custom decoder registered with
+// RegisterConverter, by the PLY property type it decodes and the Go field
+// kind it targets.
+type converterKey struct {
+	plyType string
+	goKind  reflect.Kind
+}
+
+var converters sync.Map
+
+// RegisterConverter installs a custom decoder for PLY properties of type
+// plyType when bound to a Go struct field of kind goKind, overriding the
+// default encoding/binary decode for that pairing on every subsequent
+// read. fn receives the property's raw row bytes and returns the value to
+// assign, e.g. to map a `float` property into a `float64` field, or to
+// unpack a multi-component property into an array field.
+func RegisterConverter(plyType string, goKind reflect.Kind, fn func([]byte) any) {
+	converters.Store(converterKey{plyType, goKind}, fn)
+}
+
+func lookupConverter(plyType string, goKind reflect.Kind) (func([]byte) any, bool) {
+	v, ok := converters.Load(converterKey{plyType, goKind})
+	if !ok {
+		return nil, false
+	}
+	return v.(func([]byte) any), true
+}
+
+// rowField binds one element property to a target struct field: its byte
+// offset within a fixed-size row, and, if RegisterConverter was used for
+// this (PLY type, Go kind) pairing, the converter to decode it with.
+type rowField struct {
+	prop       *property
+	fieldIndex int
+	byteOffset int
+	converter  func([]byte) any
+}
+
+// rowPlanKey caches a built row plan per element and Go type, since the
+// plan depends on both: the element supplies the on-disk property order
+// and the type supplies which of those properties have tagged fields.
+type rowPlanKey struct {
+	elem *element
+	typ  reflect.Type
+}
+
+var rowPlanCache sync.Map
+
+// getRowPlan resolves the field plan for element e and struct type t,
+// building it on first use and caching it thereafter so repeated reads
+// (ReadRange call after ReadRange call) only pay the reflection cost
+// once.
+func getRowPlan(e *element, t reflect.Type) []rowField {
+	key := rowPlanKey{elem: e, typ: t}
+	if cached, ok := rowPlanCache.Load(key); ok {
+		return cached.([]rowField)
+	}
+
+	plan := buildRowPlan(e, t)
+	rowPlanCache.Store(key, plan)
+	return plan
+}
+
+// buildRowPlan combines the element's on-disk property layout with the
+// type's cached tag-to-field mapping, resolving any registered converter
+// for each property/field pairing up front.
+func buildRowPlan(e *element, t reflect.Type) []rowField {
+	tagMap := tagFieldIndex(t)
+	plan := make([]rowField, len(e.Properties))
+
+	offset := 0
+	for i, prop := range e.Properties {
+		rf := rowField{prop: prop, fieldIndex: -1, byteOffset: offset}
+
+		if fieldIndex, ok := tagMap[prop.Name]; ok {
+			rf.fieldIndex = fieldIndex
+			if fn, ok := lookupConverter(prop.Type, t.Field(fieldIndex).Type.Kind()); ok {
+				rf.converter = fn
+			}
+		}
+
+		plan[i] = rf
+		if !prop.IsList {
+			offset += prop.Size
+		}
+	}
+
+	return plan
+}
+
+// assignConverted assigns the result of a custom converter to field,
+// converting element-wise if the converter returned a slice or array for
+// an array/slice field.
+func assignConverted(field reflect.Value, result interface{}) {
+	rv := reflect.ValueOf(result)
+
+	if field.Kind() == reflect.Array || field.Kind() == reflect.Slice {
+		if rv.Kind() != reflect.Array && rv.Kind() != reflect.Slice {
+			return
+		}
+
+		n := rv.Len()
+		if field.Kind() == reflect.Slice {
+			field.Set(reflect.MakeSlice(field.Type(), n, n))
+		} else if n > field.Len() {
+			n = field.Len()
+		}
+
+		for i := 0; i < n; i++ {
+			assignReflectValue(field.Index(i), rv.Index(i).Interface())
+		}
+		return
+	}
+
+	assignReflectValue(field, result)
+}
+
+// ReadRange reads count rows starting at start into *slicePtr (a pointer to
+// a slice of the target struct type), growing or reusing its backing array
+// as needed, and returns the number of rows actually read. For fixed-size
+// binary elements it resolves the struct's field layout once and then
+// reads the whole count*rowSize block in a single seek and read, which
+// avoids the per-row syscall and reflection overhead ReadNext would
+// otherwise pay on every row. Ascii files and elements with list
+// properties fall back to reading row by row, since neither has a fixed
+// row size to block-read.
+func (r *ElementReader) ReadRange(start, count int64, slicePtr interface{}) (int64, error) {
+	if start < 0 || start > r.element.Count {
+		return 0, errors.New(fmt.Sprintf("can't offset on %d position", start))
+	}
+	if start+count > r.element.Count {
+		count = r.element.Count - start
+	}
+	if count <= 0 {
+		return 0, nil
+	}
+
+	sliceVal := reflect.ValueOf(slicePtr)
+	if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
+		return 0, errors.New("slicePtr must be a pointer to a slice")
+	}
+	sliceVal = sliceVal.Elem()
+	elemType := sliceVal.Type().Elem()
+
+	if int64(sliceVal.Cap()) < count {
+		sliceVal.Set(reflect.MakeSlice(sliceVal.Type(), int(count), int(count)))
+	} else {
+		sliceVal.SetLen(int(count))
+	}
+
+	if r.format != FormatAscii && !r.element.isVariable() {
+		return r.readBinaryRange(start, count, sliceVal, elemType)
+	}
+
+	for i := int64(0); i < count; i++ {
+		item := reflect.New(elemType)
+
+		var err error
+		if r.format == FormatAscii {
+			err = r.readAsciiRow(start+i, item.Interface())
+		} else {
+			err = r.readBinaryRow(start+i, item.Interface())
+		}
+		if err != nil {
+			return i, err
+		}
+
+		sliceVal.Index(int(i)).Set(item.Elem())
+	}
+
+	return count, nil
+}
+
+// readBinaryRange implements the ReadRange fast path for fixed-size binary
+// rows: one seek, one read of the whole block, then decode each row in
+// place using a field plan resolved once for elemType.
+func (r *ElementReader) readBinaryRange(start, count int64, sliceVal reflect.Value, elemType reflect.Type) (int64, error) {
+	rowSize := int64(r.element.PointByteSize())
+	plan := getRowPlan(r.element, elemType)
+
+	if _, err := r.file.Seek(r.offset+start*rowSize, 0); err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, count*rowSize)
+	if _, err := io.ReadFull(r.file, buf); err != nil {
+		return 0, err
+	}
+
+	for i := int64(0); i < count; i++ {
+		rowBuf := buf[i*rowSize : (i+1)*rowSize]
+		target := sliceVal.Index(int(i))
+
+		for _, f := range plan {
+			if f.fieldIndex < 0 || f.prop.IsList {
+				continue
+			}
+			bits := rowBuf[f.byteOffset : f.byteOffset+f.prop.Size]
+			field := target.Field(f.fieldIndex)
+			if f.converter != nil {
+				assignConverted(field, f.converter(bits))
+			} else {
+				assignReflectValue(field, decodeBinaryScalar(f.prop.Type, bits, r.order))
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// readBinaryRow seeks to the row at pos and decodes it into pointer using
+// r.order, the byte order selected from the header's format line. Fixed-size
+// elements are located by simple arithmetic; elements with list properties
+// are located through the rowOffsets index built by PlyFile.buildLayout.
+func (r *ElementReader) readBinaryRow(pos int64, pointer interface{}) error {
+	var rowStart, rowLen int64
+
+	if r.element.isVariable() {
+		rowStart = r.element.rowOffsets[pos]
+		rowLen = r.element.rowOffsets[pos+1] - rowStart
+	} else {
+		rowLen = int64(r.element.PointByteSize())
+		rowStart = r.offset + pos*rowLen
+	}
+
+	if _, err := r.file.Seek(rowStart, 0); err != nil {
+		return err
+	}
+
+	buf := make([]byte, rowLen)
+	if _, err := io.ReadFull(r.file, buf); err != nil {
+		return err
 	}
 
 	offset := 0
@@ -282,51 +738,271 @@ func (r *ElementReader) ReadNext(pointer interface{}) (int64, error) {
 	for i := 0; i < len(r.element.Properties); i++ {
 		prop := r.element.Properties[i]
 
-		t := reflect.TypeOf(pointer).Elem()
-		v := reflect.Indirect(reflect.ValueOf(pointer))
-		if t.Kind() == reflect.Struct {
-			for i := 0; i < v.NumField(); i++ {
-				if t.Field(i).Tag.Get("ply") == prop.Name {
-					switch prop.Type {
-					case "char", "uchar":
-						v := byte(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "short":
-						v := int16(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "ushort":
-						v := uint16(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "int":
-						v := int32(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "uint":
-						v := uint32(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "float":
-						v := float32(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					case "double":
-						v := float64(0)
-						memcpy(buf[offset:offset+prop.Size], unsafe.Pointer(&v))
-						reflect.ValueOf(pointer).Elem().Field(i).Set(reflect.ValueOf(v))
-					}
-				}
+		if prop.IsList {
+			countSize := propertySize(prop.CountType)
+			count := decodeBinaryUint(prop.CountType, buf[offset:offset+countSize], r.order)
+			offset += countSize
+
+			valSize := propertySize(prop.ValueType)
+			values := make([]interface{}, count)
+			for k := uint64(0); k < count; k++ {
+				values[k] = decodeBinaryScalar(prop.ValueType, buf[offset:offset+valSize], r.order)
+				offset += valSize
 			}
+
+			setSliceField(pointer, prop.Name, values)
+			continue
 		}
 
+		setFieldFromBinary(pointer, prop, buf[offset:offset+prop.Size], r.order)
 		offset += prop.Size
 	}
 
-	r.pos++
+	return nil
+}
 
-	return r.pos, nil
+// asciiScanBufSize/asciiScanMaxTokenSize size the ascii line scanner's
+// buffer well past bufio.Scanner's 64 KB default, since a `property list`
+// row (e.g. a face with many vertex indices) can exceed that on its own.
+const asciiScanBufSize = 1 << 20
+const asciiScanMaxTokenSize = 1 << 24
+
+// readAsciiRow locates the ascii line belonging to this element at the
+// given row, tokenizes it and parses each token according to the
+// property's declared type. Sequential calls (increasing pos) resume the
+// reader's scanner rather than rescanning from the body start, so
+// reading a whole element stays O(n) instead of O(n^2); only a backward
+// seek pays for a rescan.
+func (r *ElementReader) readAsciiRow(pos int64, pointer interface{}) error {
+	targetLine := r.lineOffset + pos
+
+	if r.asciiScanner == nil || targetLine < r.asciiNextLine {
+		r.asciiScanner = bufio.NewScanner(&offsetReader{r: r.file, pos: r.offset})
+		r.asciiScanner.Buffer(make([]byte, asciiScanBufSize), asciiScanMaxTokenSize)
+		r.asciiNextLine = 0
+	}
+
+	var line string
+	for r.asciiNextLine <= targetLine {
+		if !r.asciiScanner.Scan() {
+			return io.EOF
+		}
+		line = r.asciiScanner.Text()
+		r.asciiNextLine++
+	}
+
+	fields := strings.Fields(line)
+
+	idx := 0
+	for i := 0; i < len(r.element.Properties) && idx < len(fields); i++ {
+		prop := r.element.Properties[i]
+
+		if prop.IsList {
+			count, _ := strconv.ParseUint(fields[idx], 10, 64)
+			idx++
+
+			values := make([]interface{}, 0, count)
+			for k := uint64(0); k < count && idx < len(fields); k++ {
+				values = append(values, parseAsciiScalar(prop.ValueType, fields[idx]))
+				idx++
+			}
+
+			setSliceField(pointer, prop.Name, values)
+			continue
+		}
+
+		setFieldFromAscii(pointer, prop, fields[idx])
+		idx++
+	}
+
+	return nil
+}
+
+// decodeBinaryScalar decodes a single property value from raw bytes
+// according to its PLY type, using order for multi-byte types.
+func decodeBinaryScalar(ptype string, bits []byte, order binary.ByteOrder) interface{} {
+	switch ptype {
+	case "char":
+		return int8(bits[0])
+	case "uchar":
+		return bits[0]
+	case "short":
+		return int16(order.Uint16(bits))
+	case "ushort":
+		return order.Uint16(bits)
+	case "int":
+		return int32(order.Uint32(bits))
+	case "uint":
+		return order.Uint32(bits)
+	case "float":
+		return math.Float32frombits(order.Uint32(bits))
+	case "double":
+		return math.Float64frombits(order.Uint64(bits))
+	}
+	return nil
+}
+
+// decodeBinaryUint decodes a property value as an unsigned integer,
+// regardless of its declared width. It's used for list-property counts,
+// which PLY allows to be declared as any integer type.
+func decodeBinaryUint(ptype string, bits []byte, order binary.ByteOrder) uint64 {
+	switch ptype {
+	case "char", "uchar":
+		return uint64(bits[0])
+	case "short", "ushort":
+		return uint64(order.Uint16(bits))
+	case "int", "uint":
+		return uint64(order.Uint32(bits))
+	case "double":
+		return order.Uint64(bits)
+	}
+	return 0
+}
+
+// parseAsciiScalar parses a single whitespace-delimited token according to
+// its declared PLY type.
+func parseAsciiScalar(ptype string, token string) interface{} {
+	switch ptype {
+	case "char":
+		n, _ := strconv.ParseInt(token, 10, 8)
+		return int8(n)
+	case "uchar":
+		n, _ := strconv.ParseUint(token, 10, 8)
+		return byte(n)
+	case "short":
+		n, _ := strconv.ParseInt(token, 10, 16)
+		return int16(n)
+	case "ushort":
+		n, _ := strconv.ParseUint(token, 10, 16)
+		return uint16(n)
+	case "int":
+		n, _ := strconv.ParseInt(token, 10, 32)
+		return int32(n)
+	case "uint":
+		n, _ := strconv.ParseUint(token, 10, 32)
+		return uint32(n)
+	case "float":
+		n, _ := strconv.ParseFloat(token, 32)
+		return float32(n)
+	case "double":
+		n, _ := strconv.ParseFloat(token, 64)
+		return n
+	}
+	return nil
+}
+
+// setFieldFromBinary decodes raw, byte-order-aware bytes for a single
+// scalar property and assigns it to the tagged struct field on pointer.
+func setFieldFromBinary(pointer interface{}, prop *property, bits []byte, order binary.ByteOrder) {
+	field, ok := taggedField(pointer, prop.Name)
+	if !ok {
+		return
+	}
+
+	if fn, ok := lookupConverter(prop.Type, field.Kind()); ok {
+		assignConverted(field, fn(bits))
+		return
+	}
+
+	assignReflectValue(field, decodeBinaryScalar(prop.Type, bits, order))
+}
+
+// setFieldFromAscii parses a single whitespace-delimited token according to
+// the property's declared type and assigns it to the tagged struct field.
+func setFieldFromAscii(pointer interface{}, prop *property, token string) {
+	field, ok := taggedField(pointer, prop.Name)
+	if !ok {
+		return
+	}
+	assignReflectValue(field, parseAsciiScalar(prop.Type, token))
+}
+
+// setSliceField assigns a decoded `property list` row to the slice field
+// tagged with name, e.g. `ply:"vertex_indices"` on an `Indices []int32`
+// field. Each element of values is converted to the field's element type.
+func setSliceField(pointer interface{}, name string, values []interface{}) {
+	field, ok := taggedField(pointer, name)
+	if !ok || field.Kind() != reflect.Slice {
+		return
+	}
+
+	slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+	for i, value := range values {
+		assignReflectValue(slice.Index(i), value)
+	}
+	field.Set(slice)
+}
+
+// taggedField looks up the struct field on pointer tagged `ply:"name"`.
+func taggedField(pointer interface{}, name string) (reflect.Value, bool) {
+	t := reflect.TypeOf(pointer).Elem()
+	if t.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("ply") == name {
+			return reflect.ValueOf(pointer).Elem().Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// assignReflectValue assigns a decoded Go value (one of byte, int16,
+// uint16, int32, uint32, float32 or float64) to field, converting by kind
+// so e.g. a PLY `int` can bind to a Go `int` field and not just `int32`.
+func assignReflectValue(field reflect.Value, value interface{}) {
+	rv := reflect.ValueOf(value)
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		field.SetInt(asInt64(rv))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		field.SetUint(asUint64(rv))
+	case reflect.Float32, reflect.Float64:
+		field.SetFloat(asFloat64(rv))
+	default:
+		if field.Type() == rv.Type() {
+			field.Set(rv)
+		}
+	}
+}
+
+func asInt64(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int64(v.Float())
+	}
+	return 0
+}
+
+func asUint64(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return uint64(v.Float())
+	}
+	return 0
+}
+
+func asFloat64(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return 0
 }
 
 func (r *ElementReader) ReadAt(pos int64, pointer interface{}) error {
@@ -0,0 +1,448 @@
+package plyfile
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, content string) string {
+	t.Helper()
+
+	f, err := os.CreateTemp("", "*.ply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { os.Remove(f.Name()) })
+	return f.Name()
+}
+
+func TestOpenAsciiFormat(t *testing.T) {
+	content := "ply\nformat ascii 1.0\nelement vertex 2\nproperty float x\nproperty float y\nproperty float z\nend_header\n0 1 2\n3 4 5\n"
+	name := writeTempFile(t, content)
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v Point
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != 0 || v.Y != 1 || v.Z != 2 {
+		t.Fatalf("unexpected row 0: %+v", v)
+	}
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != 3 || v.Y != 4 || v.Z != 5 {
+		t.Fatalf("unexpected row 1: %+v", v)
+	}
+}
+
+func TestOpenBigEndianFormat(t *testing.T) {
+	header := "ply\nformat binary_big_endian 1.0\nelement vertex 1\nproperty float x\nproperty float y\nproperty float z\nend_header\n"
+
+	var b strings.Builder
+	b.WriteString(header)
+	name := writeTempFile(t, b.String())
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.Write(f, binary.BigEndian, float32(1.5))
+	binary.Write(f, binary.BigEndian, float32(2.5))
+	binary.Write(f, binary.BigEndian, float32(3.5))
+	f.Close()
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v Point
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != 1.5 || v.Y != 2.5 || v.Z != 3.5 {
+		t.Fatalf("big-endian decode mismatch: %+v", v)
+	}
+}
+
+func TestPropertyListAscii(t *testing.T) {
+	content := "ply\n" +
+		"format ascii 1.0\n" +
+		"element vertex 2\n" +
+		"property float x\nproperty float y\nproperty float z\n" +
+		"element face 1\n" +
+		"property list uchar int vertex_indices\n" +
+		"end_header\n" +
+		"0 1 2\n3 4 5\n3 0 1 2\n"
+	name := writeTempFile(t, content)
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	type face struct {
+		Indices []int32 `ply:"vertex_indices"`
+	}
+
+	fr, err := p.GetElementReader("face")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fc face
+	if _, err := fr.ReadNext(&fc); err != nil {
+		t.Fatal(err)
+	}
+	if len(fc.Indices) != 3 || fc.Indices[0] != 0 || fc.Indices[2] != 2 {
+		t.Fatalf("unexpected face: %+v", fc)
+	}
+}
+
+func TestPropertyListBinaryRandomAccess(t *testing.T) {
+	header := "ply\nformat binary_little_endian 1.0\nelement face 2\nproperty list uchar int vertex_indices\nend_header\n"
+
+	name := writeTempFile(t, header)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Write([]byte{3})
+	binary.Write(f, binary.LittleEndian, int32(0))
+	binary.Write(f, binary.LittleEndian, int32(1))
+	binary.Write(f, binary.LittleEndian, int32(2))
+
+	f.Write([]byte{4})
+	binary.Write(f, binary.LittleEndian, int32(3))
+	binary.Write(f, binary.LittleEndian, int32(4))
+	binary.Write(f, binary.LittleEndian, int32(5))
+	binary.Write(f, binary.LittleEndian, int32(6))
+	f.Close()
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	type face struct {
+		Indices []int32 `ply:"vertex_indices"`
+	}
+
+	fr, err := p.GetElementReader("face")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var f0, f1 face
+	if _, err := fr.ReadNext(&f0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fr.ReadNext(&f1); err != nil {
+		t.Fatal(err)
+	}
+	if len(f0.Indices) != 3 || len(f1.Indices) != 4 || f1.Indices[3] != 6 {
+		t.Fatalf("unexpected faces: %+v %+v", f0, f1)
+	}
+
+	// rowOffsets must make this O(1), not a rescan from the start
+	var again face
+	if err := fr.ReadAt(0, &again); err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Indices) != 3 || again.Indices[0] != 0 {
+		t.Fatalf("unexpected reread: %+v", again)
+	}
+}
+
+type rangeVertex struct {
+	X float32 `ply:"x"`
+	Y float32 `ply:"y"`
+	Z float32 `ply:"z"`
+}
+
+func TestReadRangeBulk(t *testing.T) {
+	header := "ply\nformat binary_little_endian 1.0\nelement vertex 3\nproperty float x\nproperty float y\nproperty float z\nend_header\n"
+	name := writeTempFile(t, header)
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		binary.Write(f, binary.LittleEndian, float32(i))
+		binary.Write(f, binary.LittleEndian, float32(i+10))
+		binary.Write(f, binary.LittleEndian, float32(i+20))
+	}
+	f.Close()
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pts []rangeVertex
+	n, err := vr.ReadRange(0, 3, &pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || len(pts) != 3 || pts[0].X != 0 || pts[1].Y != 11 || pts[2].Z != 22 {
+		t.Fatalf("unexpected ReadRange result: n=%d pts=%+v", n, pts)
+	}
+
+	// a range overrunning the element count must clamp, not error
+	var tail []rangeVertex
+	n, err = vr.ReadRange(2, 5, &tail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 || len(tail) != 1 || tail[0].X != 2 {
+		t.Fatalf("unexpected clamped ReadRange result: n=%d tail=%+v", n, tail)
+	}
+}
+
+func TestRegisterConverter(t *testing.T) {
+	RegisterConverter("float", reflect.Float64, func(b []byte) any {
+		return float64(binary.LittleEndian.Uint32(b))
+	})
+	defer converters.Delete(converterKey{"float", reflect.Float64})
+
+	header := "ply\nformat binary_little_endian 1.0\nelement vertex 1\nproperty float x\nend_header\n"
+	name := writeTempFile(t, header)
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.Write(f, binary.LittleEndian, float32(1))
+	f.Close()
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	type vertex struct {
+		X float64 `ply:"x"`
+	}
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v vertex
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	// the registered converter reinterprets the float32 bit pattern as an
+	// integer instead of decoding it as a float, so it must diverge from
+	// the default decode of float32(1) == 1.0
+	if v.X == 1.0 {
+		t.Fatalf("expected registered converter to override default decode, got %+v", v)
+	}
+}
+
+func TestCharPropertyIsSigned(t *testing.T) {
+	header := "ply\nformat binary_little_endian 1.0\nelement vertex 1\nproperty char x\nend_header\n"
+	name := writeTempFile(t, header)
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0xFF}) // -1 as a signed char
+	f.Close()
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	type vertex struct {
+		X int32 `ply:"x"`
+	}
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v vertex
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != -1 {
+		t.Fatalf("expected signed char 0xFF to decode as -1, got %d", v.X)
+	}
+}
+
+func TestCharPropertyAsciiIsSigned(t *testing.T) {
+	content := "ply\nformat ascii 1.0\nelement vertex 1\nproperty char x\nend_header\n-1\n"
+	name := writeTempFile(t, content)
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	type vertex struct {
+		X int32 `ply:"x"`
+	}
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v vertex
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != -1 {
+		t.Fatalf("expected ascii char \"-1\" to decode as -1, got %d", v.X)
+	}
+}
+
+func TestAsciiSequentialReadIsLinear(t *testing.T) {
+	const n = 2000
+
+	var b strings.Builder
+	b.WriteString("ply\nformat ascii 1.0\n")
+	b.WriteString(fmt.Sprintf("element vertex %d\n", n))
+	b.WriteString("property float x\nproperty float y\nproperty float z\nend_header\n")
+	for i := 0; i < n; i++ {
+		b.WriteString(fmt.Sprintf("%d %d %d\n", i, i, i))
+	}
+	name := writeTempFile(t, b.String())
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v Point
+	for i := 0; i < n; i++ {
+		if _, err := vr.ReadNext(&v); err != nil {
+			t.Fatalf("row %d: %v", i, err)
+		}
+		if int(v.X) != i {
+			t.Fatalf("row %d: unexpected value %+v", i, v)
+		}
+	}
+
+	if _, err := vr.ReadNext(&v); err != io.EOF {
+		t.Fatalf("expected io.EOF past the last row, got %v", err)
+	}
+}
+
+func TestInterleavedAsciiReadersDontCorruptEachOther(t *testing.T) {
+	// Large enough that the vertex reader's scanner buffer needs at least
+	// one refill partway through, which is what exposes a reader trusting
+	// the shared file's position rather than its own tracked offset.
+	const n = 70000
+
+	var b strings.Builder
+	b.WriteString("ply\nformat ascii 1.0\n")
+	b.WriteString(fmt.Sprintf("element vertex %d\n", n))
+	b.WriteString("property float x\nproperty float y\nproperty float z\n")
+	b.WriteString("element face 1\nproperty list uchar int vertex_indices\n")
+	b.WriteString("end_header\n")
+	for i := 0; i < n; i++ {
+		b.WriteString(fmt.Sprintf("%d %d %d\n", i, i, i))
+	}
+	b.WriteString("3 0 1 2\n")
+	name := writeTempFile(t, b.String())
+
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fr, err := p.GetElementReader("face")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v Point
+	for i := 0; i < 10; i++ {
+		if _, err := vr.ReadNext(&v); err != nil {
+			t.Fatalf("row %d: %v", i, err)
+		}
+	}
+
+	// Reading the face element seeks the file PlyFile.file is shared with
+	// the vertex reader; the vertex reader's next buffer refill must not
+	// be derailed by it.
+	type face struct {
+		Indices []int32 `ply:"vertex_indices"`
+	}
+	var fc face
+	if _, err := fr.ReadNext(&fc); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 10; i < n; i++ {
+		if _, err := vr.ReadNext(&v); err != nil {
+			t.Fatalf("row %d: %v", i, err)
+		}
+		if int(v.X) != i {
+			t.Fatalf("row %d: unexpected value %+v", i, v)
+		}
+	}
+
+	if _, err := vr.ReadNext(&v); err != io.EOF {
+		t.Fatalf("expected io.EOF past the last row, got %v", err)
+	}
+}
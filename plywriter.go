@@ -0,0 +1,292 @@
+package plyfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Property declares a single element property when building a file with
+// PlyWriter.DeclareElement. It mirrors the reader's property grammar: set
+// Type for a scalar property, or IsList/CountType/ValueType for a
+// `property list` property such as a face's vertex_indices.
+type Property struct {
+	Name string
+	Type string
+
+	IsList    bool
+	CountType string
+	ValueType string
+}
+
+// PlyWriter writes PLY files in any of the three standard formats, using
+// the same `ply:"name"` struct-tag convention as PlyFile/ElementReader.
+type PlyWriter struct {
+	file          *os.File
+	format        string
+	order         binary.ByteOrder
+	elements      []*element
+	headerWritten bool
+}
+
+// Create opens name for writing and prepares a PlyWriter for the given
+// format (one of FormatAscii, FormatBinaryLittleEndian or
+// FormatBinaryBigEndian). Call DeclareElement for each element, then
+// WriteHeader before the first WriteRow.
+func Create(name, format string) (*PlyWriter, error) {
+	switch format {
+	case FormatAscii, FormatBinaryLittleEndian, FormatBinaryBigEndian:
+		// supported
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported format '%s'", format))
+	}
+
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlyWriter{
+		file:   file,
+		format: format,
+		order:  byteOrder(format),
+	}, nil
+}
+
+func (w *PlyWriter) Close() error {
+	return w.file.Close()
+}
+
+// DeclareElement registers an element and its properties, in the order
+// they should appear in the header. count is the number of rows the
+// caller intends to write with WriteRow.
+func (w *PlyWriter) DeclareElement(name string, count int64, props []Property) {
+	e := &element{Name: name, Count: count}
+
+	for _, p := range props {
+		if p.IsList {
+			e.Properties = append(e.Properties, &property{
+				Name:      p.Name,
+				IsList:    true,
+				CountType: p.CountType,
+				ValueType: p.ValueType,
+			})
+		} else {
+			e.Properties = append(e.Properties, &property{
+				Name: p.Name,
+				Type: p.Type,
+				Size: propertySize(p.Type),
+			})
+		}
+	}
+
+	w.elements = append(w.elements, e)
+}
+
+func (w *PlyWriter) getElement(name string) *element {
+	for _, e := range w.elements {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// WriteHeader writes the `ply`/`format`/`element`/`property`/`end_header`
+// block describing every element declared so far. It must be called
+// exactly once, after all DeclareElement calls and before the first
+// WriteRow.
+func (w *PlyWriter) WriteHeader() error {
+	if w.headerWritten {
+		return errors.New("header already written")
+	}
+
+	var b strings.Builder
+	b.WriteString("ply\n")
+	b.WriteString(fmt.Sprintf("format %s 1.0\n", w.format))
+
+	for _, e := range w.elements {
+		b.WriteString(fmt.Sprintf("element %s %d\n", e.Name, e.Count))
+		for _, p := range e.Properties {
+			if p.IsList {
+				b.WriteString(fmt.Sprintf("property list %s %s %s\n", p.CountType, p.ValueType, p.Name))
+			} else {
+				b.WriteString(fmt.Sprintf("property %s %s\n", p.Type, p.Name))
+			}
+		}
+	}
+
+	b.WriteString(headerEnd + "\n")
+
+	if _, err := w.file.WriteString(b.String()); err != nil {
+		return err
+	}
+
+	w.headerWritten = true
+	return nil
+}
+
+// WriteRow writes one row of the named element, reading each declared
+// property from the matching `ply:"name"` tagged field of v (a struct or
+// pointer to struct). List properties are read from a slice field.
+func (w *PlyWriter) WriteRow(elementName string, v interface{}) error {
+	if !w.headerWritten {
+		return errors.New("header not written")
+	}
+
+	e := w.getElement(elementName)
+	if e == nil {
+		return errors.New(fmt.Sprintf("unknown element '%s'", elementName))
+	}
+
+	if w.format == FormatAscii {
+		return w.writeAsciiRow(e, v)
+	}
+	return w.writeBinaryRow(e, v)
+}
+
+func (w *PlyWriter) writeAsciiRow(e *element, v interface{}) error {
+	tokens := make([]string, 0, len(e.Properties))
+
+	for _, prop := range e.Properties {
+		if prop.IsList {
+			values := sliceFieldValues(v, prop.Name)
+			tokens = append(tokens, fmt.Sprintf("%d", len(values)))
+			for _, value := range values {
+				tokens = append(tokens, fmt.Sprintf("%v", value))
+			}
+			continue
+		}
+
+		value, ok := taggedValue(v, prop.Name)
+		if !ok {
+			continue
+		}
+		tokens = append(tokens, fmt.Sprintf("%v", value.Interface()))
+	}
+
+	_, err := w.file.WriteString(strings.Join(tokens, " ") + "\n")
+	return err
+}
+
+func (w *PlyWriter) writeBinaryRow(e *element, v interface{}) error {
+	buf := new(bytes.Buffer)
+
+	for _, prop := range e.Properties {
+		if prop.IsList {
+			values := sliceFieldValues(v, prop.Name)
+			writeBinaryUint(buf, prop.CountType, uint64(len(values)), w.order)
+			for _, value := range values {
+				writeBinaryScalar(buf, prop.ValueType, value, w.order)
+			}
+			continue
+		}
+
+		value, ok := taggedValue(v, prop.Name)
+		if !ok {
+			continue
+		}
+		writeBinaryScalar(buf, prop.Type, value.Interface(), w.order)
+	}
+
+	_, err := w.file.Write(buf.Bytes())
+	return err
+}
+
+// taggedValue looks up the value of the struct field on v (a struct or a
+// pointer to one) tagged `ply:"name"`.
+func taggedValue(v interface{}, name string) (reflect.Value, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	t := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		if t.Field(i).Tag.Get("ply") == name {
+			return rv.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// sliceFieldValues reads a `ply:"name"` tagged slice field of v into a
+// plain []interface{}, one entry per element.
+func sliceFieldValues(v interface{}, name string) []interface{} {
+	field, ok := taggedValue(v, name)
+	if !ok || field.Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := make([]interface{}, field.Len())
+	for i := 0; i < field.Len(); i++ {
+		values[i] = field.Index(i).Interface()
+	}
+	return values
+}
+
+// writeBinaryScalar converts value (read from a Go struct field of any
+// numeric kind) to the width and encoding of ptype and writes it to buf
+// using order.
+func writeBinaryScalar(buf *bytes.Buffer, ptype string, value interface{}, order binary.ByteOrder) {
+	rv := reflect.ValueOf(value)
+
+	switch ptype {
+	case "char", "uchar":
+		buf.WriteByte(byte(asUint64(rv)))
+	case "short":
+		b := make([]byte, 2)
+		order.PutUint16(b, uint16(asInt64(rv)))
+		buf.Write(b)
+	case "ushort":
+		b := make([]byte, 2)
+		order.PutUint16(b, uint16(asUint64(rv)))
+		buf.Write(b)
+	case "int":
+		b := make([]byte, 4)
+		order.PutUint32(b, uint32(asInt64(rv)))
+		buf.Write(b)
+	case "uint":
+		b := make([]byte, 4)
+		order.PutUint32(b, uint32(asUint64(rv)))
+		buf.Write(b)
+	case "float":
+		b := make([]byte, 4)
+		order.PutUint32(b, math.Float32bits(float32(asFloat64(rv))))
+		buf.Write(b)
+	case "double":
+		b := make([]byte, 8)
+		order.PutUint64(b, math.Float64bits(asFloat64(rv)))
+		buf.Write(b)
+	}
+}
+
+// writeBinaryUint writes an unsigned count (a list property's length
+// prefix) at the width of ctype.
+func writeBinaryUint(buf *bytes.Buffer, ctype string, n uint64, order binary.ByteOrder) {
+	switch ctype {
+	case "char", "uchar":
+		buf.WriteByte(byte(n))
+	case "short", "ushort":
+		b := make([]byte, 2)
+		order.PutUint16(b, uint16(n))
+		buf.Write(b)
+	case "int", "uint":
+		b := make([]byte, 4)
+		order.PutUint32(b, uint32(n))
+		buf.Write(b)
+	case "double":
+		b := make([]byte, 8)
+		order.PutUint64(b, n)
+		buf.Write(b)
+	}
+}
@@ -0,0 +1,187 @@
+package plyfile
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+type writerVertex struct {
+	X float32 `ply:"x"`
+	Y float32 `ply:"y"`
+	Z float32 `ply:"z"`
+}
+
+type writerFace struct {
+	Indices []int32 `ply:"vertex_indices"`
+}
+
+func vertexProperties() []Property {
+	return []Property{
+		{Name: "x", Type: "float"},
+		{Name: "y", Type: "float"},
+		{Name: "z", Type: "float"},
+	}
+}
+
+func faceProperties() []Property {
+	return []Property{
+		{Name: "vertex_indices", IsList: true, CountType: "uchar", ValueType: "int"},
+	}
+}
+
+// roundTrip writes vertices/faces with format, reads them back with
+// PlyFile, writes them again to a second file and returns both files'
+// bytes so the caller can assert a byte-exact round trip.
+func roundTrip(t *testing.T, format string, vertices []writerVertex, faces []writerFace) ([]byte, []byte) {
+	t.Helper()
+
+	first := writeTempFile(t, "")
+	w, err := Create(first, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.DeclareElement("vertex", int64(len(vertices)), vertexProperties())
+	w.DeclareElement("face", int64(len(faces)), faceProperties())
+	if err := w.WriteHeader(); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vertices {
+		if err := w.WriteRow("vertex", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range faces {
+		if err := w.WriteRow("face", f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	firstBytes, err := os.ReadFile(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := Open(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	readVertices := make([]writerVertex, len(vertices))
+	for i := range readVertices {
+		if _, err := vr.ReadNext(&readVertices[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fr, err := p.GetElementReader("face")
+	if err != nil {
+		t.Fatal(err)
+	}
+	readFaces := make([]writerFace, len(faces))
+	for i := range readFaces {
+		if _, err := fr.ReadNext(&readFaces[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	second := writeTempFile(t, "")
+	w2, err := Create(second, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w2.DeclareElement("vertex", int64(len(readVertices)), vertexProperties())
+	w2.DeclareElement("face", int64(len(readFaces)), faceProperties())
+	if err := w2.WriteHeader(); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range readVertices {
+		if err := w2.WriteRow("vertex", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range readFaces {
+		if err := w2.WriteRow("face", f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w2.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	secondBytes, err := os.ReadFile(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return firstBytes, secondBytes
+}
+
+func TestRoundTripBinaryLittleEndianByteCompare(t *testing.T) {
+	vertices := []writerVertex{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}}
+	faces := []writerFace{{Indices: []int32{0, 1, 2}}}
+
+	first, second := roundTrip(t, FormatBinaryLittleEndian, vertices, faces)
+	if !bytes.Equal(first, second) {
+		t.Fatalf("round-tripped binary_little_endian bytes differ:\nfirst:  %x\nsecond: %x", first, second)
+	}
+}
+
+func TestRoundTripBinaryBigEndianByteCompare(t *testing.T) {
+	vertices := []writerVertex{{X: -1.5, Y: 2.5, Z: 100}, {X: 4, Y: 5, Z: 6}}
+	faces := []writerFace{{Indices: []int32{2, 1, 0}}, {Indices: []int32{0, 1, 2, 3}}}
+
+	first, second := roundTrip(t, FormatBinaryBigEndian, vertices, faces)
+	if !bytes.Equal(first, second) {
+		t.Fatalf("round-tripped binary_big_endian bytes differ:\nfirst:  %x\nsecond: %x", first, second)
+	}
+}
+
+func TestRoundTripAsciiValues(t *testing.T) {
+	// ascii formatting of a re-written value isn't guaranteed byte-for-byte
+	// (e.g. "1" vs "1.0"), so this asserts a value-level round trip instead
+	// of the binary tests' exact byte compare.
+	vertices := []writerVertex{{X: 1.5, Y: 2.5, Z: 3.5}}
+	faces := []writerFace{{Indices: []int32{0, 1, 2}}}
+
+	_, second := roundTrip(t, FormatAscii, vertices, faces)
+
+	name := writeTempFile(t, string(second))
+	p, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	vr, err := p.GetElementReader("vertex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v writerVertex
+	if _, err := vr.ReadNext(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.X != 1.5 || v.Y != 2.5 || v.Z != 3.5 {
+		t.Fatalf("unexpected round-tripped vertex: %+v", v)
+	}
+
+	fr, err := p.GetElementReader("face")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f writerFace
+	if _, err := fr.ReadNext(&f); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Indices) != 3 || f.Indices[2] != 2 {
+		t.Fatalf("unexpected round-tripped face: %+v", f)
+	}
+}